@@ -16,6 +16,26 @@ func NewBoundFromPoints(corner, oppositeCorner Point) Bound {
 	return Bound{corner, corner}.Extend(oppositeCorner)
 }
 
+// EmptyBound returns a bound that contains nothing: not even null
+// island. Unlike the zero value Bound{}, which is a valid (if
+// degenerate) bound sitting at (0, 0), EmptyBound is a distinct
+// "unset" state. Extending it adds the first point without the
+// result being pulled toward the origin, and it short-circuits
+// Contains, Intersects and Union the way an empty set should.
+func EmptyBound() Bound {
+	return Bound{
+		Min: Point{math.NaN(), math.NaN()},
+		Max: Point{math.NaN(), math.NaN()},
+	}
+}
+
+// IsValid returns false if the bound is the sentinel returned by
+// EmptyBound. A zero value Bound{} is valid; it just represents the
+// single point at (0, 0).
+func (b Bound) IsValid() bool {
+	return !math.IsNaN(b.Min[0])
+}
+
 // GeoJSONType returns the GeoJSON type for the object.
 func (b Bound) GeoJSONType() string {
 	return "Polygon"
@@ -43,8 +63,14 @@ func (b Bound) ToRing() Ring {
 	}
 }
 
-// Extend grows the bound to include the new point.
+// Extend grows the bound to include the new point. Extending the
+// sentinel returned by EmptyBound yields exactly {point, point},
+// rather than a bound pulled toward the origin.
 func (b Bound) Extend(point Point) Bound {
+	if !b.IsValid() {
+		return Bound{Min: point, Max: point}
+	}
+
 	// already included, no big deal
 	if b.Contains(point) {
 		return b
@@ -62,8 +88,16 @@ func (b Bound) Extend(point Point) Bound {
 	}
 }
 
-// Union extends this bound to contain the union of this and the given bound.
+// Union extends this bound to contain the union of this and the given
+// bound. Union(empty, b) and Union(b, empty) both return b unchanged.
 func (b Bound) Union(other Bound) Bound {
+	if !other.IsValid() {
+		return b
+	}
+	if !b.IsValid() {
+		return other
+	}
+
 	b = b.Extend(other.Min)
 	b = b.Extend(other.Max)
 	b = b.Extend(other.LeftTop())
@@ -73,8 +107,13 @@ func (b Bound) Union(other Bound) Bound {
 }
 
 // Contains determines if the point is within the bound.
-// Points on the boundary are considered within.
+// Points on the boundary are considered within. The empty bound
+// returned by EmptyBound contains nothing.
 func (b Bound) Contains(point Point) bool {
+	if !b.IsValid() {
+		return false
+	}
+
 	if point[1] < b.Min[1] || b.Max[1] < point[1] {
 		return false
 	}
@@ -87,8 +126,13 @@ func (b Bound) Contains(point Point) bool {
 }
 
 // Intersects determines if two bounds intersect.
-// Returns true if they are touching.
+// Returns true if they are touching. The empty bound returned by
+// EmptyBound never intersects anything.
 func (b Bound) Intersects(bound Bound) bool {
+	if !b.IsValid() || !bound.IsValid() {
+		return false
+	}
+
 	if (b.Max[0] < bound.Min[0]) ||
 		(b.Min[0] > bound.Max[0]) ||
 		(b.Max[1] < bound.Min[1]) ||
@@ -137,10 +181,17 @@ func (b Bound) RightBottom() Point {
 	return Point{b.Right(), b.Bottom()}
 }
 
-// IsEmpty returns true if it contains zero area or if
-// it's in some malformed negative state where the left point is larger than the right.
-// This can be caused by padding too much negative.
+// IsEmpty returns true if the bound is the explicit EmptyBound
+// sentinel, or if it's in some malformed negative state where the
+// left point is larger than the right. The latter can be caused by
+// padding too much negative. A single-point bound, e.g. the result of
+// NewBoundFromPoints(p, p), is not considered empty. IsZero reports
+// only the single-point bound at (0, 0).
 func (b Bound) IsEmpty() bool {
+	if !b.IsValid() {
+		return true
+	}
+
 	return b.Min[0] > b.Max[0] || b.Min[1] > b.Max[1]
 }
 
@@ -154,7 +205,13 @@ func (b Bound) Bound() Bound {
 	return b
 }
 
-// Equal returns if two bounds are equal.
+// Equal returns if two bounds are equal. Two empty bounds (see
+// EmptyBound) are always considered equal to each other, even though
+// their NaN corners would otherwise never compare equal with ==.
 func (b Bound) Equal(c Bound) bool {
+	if !b.IsValid() || !c.IsValid() {
+		return !b.IsValid() && !c.IsValid()
+	}
+
 	return b.Min == c.Min && b.Max == c.Max
-}
\ No newline at end of file
+}
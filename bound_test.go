@@ -0,0 +1,56 @@
+package orb
+
+import "testing"
+
+func TestEmptyBoundIsNotZeroBound(t *testing.T) {
+	if EmptyBound().IsValid() {
+		t.Error("EmptyBound() should not be valid")
+	}
+	if !(Bound{}).IsValid() {
+		t.Error("the zero value Bound{} should still be valid")
+	}
+}
+
+func TestExtendEmptyBound(t *testing.T) {
+	p := Point{5, 7}
+	got := EmptyBound().Extend(p)
+
+	want := Bound{Min: p, Max: p}
+	if got != want {
+		t.Errorf("Extend(%v) on an empty bound = %+v, want %+v", p, got, want)
+	}
+}
+
+func TestUnionWithEmptyBound(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+
+	if got := b.Union(EmptyBound()); !got.Equal(b) {
+		t.Errorf("Union(b, empty) = %+v, want %+v", got, b)
+	}
+	if got := EmptyBound().Union(b); !got.Equal(b) {
+		t.Errorf("Union(empty, b) = %+v, want %+v", got, b)
+	}
+}
+
+func TestEmptyBoundContainsAndIntersectsNothing(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+	empty := EmptyBound()
+
+	if empty.Contains(Point{0, 0}) {
+		t.Error("empty bound should not contain any point, including the origin")
+	}
+	if empty.Intersects(b) || b.Intersects(empty) {
+		t.Error("empty bound should not intersect anything")
+	}
+}
+
+func TestEmptyBoundEqual(t *testing.T) {
+	if !EmptyBound().Equal(EmptyBound()) {
+		t.Error("two empty bounds should be Equal to each other")
+	}
+
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+	if EmptyBound().Equal(b) || b.Equal(EmptyBound()) {
+		t.Error("an empty bound should never be Equal to a non-empty one")
+	}
+}
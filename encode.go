@@ -0,0 +1,174 @@
+package orb
+
+// This file implements a fixed-precision encoding of lon/lat points
+// and Bounds into integer keys, so a Bound can be used directly as a
+// range in a sorted KV store (bbolt, badger, pebble, ...) without
+// pulling in a separate geohash or S2 library.
+
+const (
+	// lonScale/latScale map the full [-180, 180] x [-90, 90] range onto
+	// a uint32, giving a resolution of roughly 360/2^32 =~ 8.4e-8
+	// degrees, comfortably under the ~1e-6 degree tolerance we target.
+	lonScale = float64(1<<32-1) / 360
+	latScale = float64(1<<32-1) / 180
+)
+
+// EncodePointBits packs a lon/lat point into a single uint64: the
+// longitude in the high 32 bits, the latitude in the low 32, each
+// linearly scaled from its full geographic range onto a uint32.
+func EncodePointBits(p Point) uint64 {
+	lon := uint32((p[0] + 180) * lonScale)
+	lat := uint32((p[1] + 90) * latScale)
+
+	return uint64(lon)<<32 | uint64(lat)
+}
+
+// DecodePointBits is the inverse of EncodePointBits. The result is
+// accurate to within the encoding's ~1e-6 degree tolerance.
+func DecodePointBits(bits uint64) Point {
+	lon := uint32(bits >> 32)
+	lat := uint32(bits)
+
+	return Point{
+		float64(lon)/lonScale - 180,
+		float64(lat)/latScale - 90,
+	}
+}
+
+// interleave spreads the low 32 bits of v across the even bit
+// positions of a uint64, so two interleave() results can be OR'd
+// (the second shifted left one bit) to produce a Morton/z-order code.
+func interleave(v uint32) uint64 {
+	x := uint64(v)
+	x = (x | (x << 16)) & 0x0000FFFF0000FFFF
+	x = (x | (x << 8)) & 0x00FF00FF00FF00FF
+	x = (x | (x << 4)) & 0x0F0F0F0F0F0F0F0F
+	x = (x | (x << 2)) & 0x3333333333333333
+	x = (x | (x << 1)) & 0x5555555555555555
+	return x
+}
+
+// mortonEncode interleaves the encoded lon/lat of a point into a
+// single z-order uint64 key.
+func mortonEncode(p Point) uint64 {
+	bits := EncodePointBits(p)
+	lon := uint32(bits >> 32)
+	lat := uint32(bits)
+
+	return interleave(lon) | (interleave(lat) << 1)
+}
+
+// EncodeRange returns the z-order key range [lo, hi] of the bound's
+// four corners. It is a cheap, conservative range: every point in the
+// bound falls within [lo, hi], but (as with any Morton range) the
+// range can also contain points outside the bound. Use
+// MortonBoundRanges for a tighter decomposition.
+func (b Bound) EncodeRange() (lo, hi uint64) {
+	corners := []Point{
+		b.Min, b.Max,
+		{b.Min[0], b.Max[1]},
+		{b.Max[0], b.Min[1]},
+	}
+
+	lo, hi = mortonEncode(corners[0]), mortonEncode(corners[0])
+	for _, c := range corners[1:] {
+		m := mortonEncode(c)
+		if m < lo {
+			lo = m
+		}
+		if m > hi {
+			hi = m
+		}
+	}
+
+	return lo, hi
+}
+
+// mortonRange is a single node in the z-order quadtree: the [lo, hi]
+// key range it covers, and the geographic Bound that range
+// corresponds to.
+type mortonRange struct {
+	lo, hi uint64
+	bound  Bound
+}
+
+// MortonBoundRanges decomposes the bound's z-order coverage into at
+// most maxRanges [lo, hi] key intervals, using the standard
+// BIGMIN/LITMAX quadtree-splitting approach: start with the single
+// range covering the whole bound, and repeatedly split the node
+// with the worst (least tight) coverage into its quadrant children
+// until the budget of maxRanges would be exceeded or every remaining
+// node is exact. A split always replaces one node with all of its
+// (up to 4) intersecting children at once - there's no such thing as
+// a partial split without leaving a coverage hole - so a split that
+// would itself blow the budget is skipped rather than taken.
+func MortonBoundRanges(b Bound, maxRanges int) [][2]uint64 {
+	if maxRanges < 1 {
+		maxRanges = 1
+	}
+
+	nodes := []mortonRange{{bound: b}}
+	nodes[0].lo, nodes[0].hi = b.EncodeRange()
+
+	for len(nodes) < maxRanges {
+		// The node whose [lo, hi] key interval is widest is the one
+		// costing the most wasted scan range relative to what it
+		// actually covers (Morton/z-order key width doesn't shrink
+		// evenly with geographic area, so this must be measured on
+		// the keys themselves, not by comparing areas). Splitting
+		// that node into quadrants tightens its range the most.
+		worst := -1
+		var worstWaste uint64
+		for i, n := range nodes {
+			waste := n.hi - n.lo
+			if waste > worstWaste {
+				worst = i
+				worstWaste = waste
+			}
+		}
+		if worst < 0 || worstWaste == 0 {
+			break
+		}
+
+		n := nodes[worst]
+		children := splitBound(n.bound)
+		replacements := make([]mortonRange, 0, len(children))
+		for _, c := range children {
+			if !b.Intersects(c) {
+				continue
+			}
+			lo, hi := c.EncodeRange()
+			replacements = append(replacements, mortonRange{lo: lo, hi: hi, bound: c})
+		}
+		if len(replacements) == 0 {
+			break
+		}
+		if len(nodes)-1+len(replacements) > maxRanges {
+			// This split would blow the budget. It's the node most
+			// worth splitting, but the budget is a hard cap, so stop
+			// rather than overshoot it.
+			break
+		}
+
+		nodes = append(nodes[:worst], append(replacements, nodes[worst+1:]...)...)
+	}
+
+	ranges := make([][2]uint64, len(nodes))
+	for i, n := range nodes {
+		ranges[i] = [2]uint64{n.lo, n.hi}
+	}
+
+	return ranges
+}
+
+// splitBound quarters a bound into its four quadtree children.
+func splitBound(b Bound) []Bound {
+	mid := b.Center()
+
+	return []Bound{
+		{Min: b.Min, Max: mid},
+		{Min: Point{mid[0], b.Min[1]}, Max: Point{b.Max[0], mid[1]}},
+		{Min: Point{b.Min[0], mid[1]}, Max: Point{mid[0], b.Max[1]}},
+		{Min: mid, Max: b.Max},
+	}
+}
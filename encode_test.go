@@ -0,0 +1,53 @@
+package orb
+
+import "testing"
+
+func TestEncodeDecodePointBitsRoundTrip(t *testing.T) {
+	cases := []Point{
+		{0, 0},
+		{-180, -90},
+		{180, 90},
+		{175.123456, -17.654321},
+	}
+
+	for _, p := range cases {
+		bits := EncodePointBits(p)
+		got := DecodePointBits(bits)
+
+		const tol = 1e-5
+		if diff := got[0] - p[0]; diff > tol || diff < -tol {
+			t.Errorf("lon round trip for %v: got %v", p, got)
+		}
+		if diff := got[1] - p[1]; diff > tol || diff < -tol {
+			t.Errorf("lat round trip for %v: got %v", p, got)
+		}
+	}
+}
+
+func TestMortonBoundRangesRespectsBudget(t *testing.T) {
+	b := Bound{Min: Point{-20, -20}, Max: Point{20, 20}}
+
+	for _, n := range []int{1, 2, 3, 5} {
+		ranges := MortonBoundRanges(b, n)
+		if len(ranges) == 0 || len(ranges) > n {
+			t.Errorf("MortonBoundRanges(b, %d) returned %d ranges, want 1..%d", n, len(ranges), n)
+		}
+	}
+}
+
+func TestMortonBoundRangesActuallySplits(t *testing.T) {
+	b := Bound{Min: Point{-20, -20}, Max: Point{20, 20}}
+
+	one := MortonBoundRanges(b, 1)
+	five := MortonBoundRanges(b, 5)
+
+	if len(five) <= len(one) {
+		t.Fatalf("asking for more ranges should split the single root range, got %d then %d", len(one), len(five))
+	}
+
+	for _, r := range five {
+		if r[1]-r[0] >= one[0][1]-one[0][0] {
+			t.Errorf("split range [%d,%d] is no tighter than the unsplit range", r[0], r[1])
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package orb
+
+// A GeoBound is a Bound that is aware of the anti-meridian.
+// Unlike Bound, a GeoBound considers Min[0] > Max[0] a legal state: it
+// means the longitude range wraps through +/-180 instead of running
+// through 0. Everything else (latitude handling, the Point corners)
+// behaves the same as Bound.
+type GeoBound struct {
+	Min, Max Point
+}
+
+// NewGeoBoundFromPoints creates a new bound given two opposite corners.
+// The longitude span is taken to be the shorter of the two possible
+// arcs between corner[0] and oppositeCorner[0], so a bound fed
+// lon 175 and lon -175 wraps through the anti-meridian instead of
+// spanning 350 degrees the other way.
+func NewGeoBoundFromPoints(corner, oppositeCorner Point) GeoBound {
+	return GeoBound{corner, corner}.Extend(oppositeCorner)
+}
+
+// Wraps returns true if the bound's longitude range crosses the
+// anti-meridian, i.e. Min[0] > Max[0].
+func (b GeoBound) Wraps() bool {
+	return b.Min[0] > b.Max[0]
+}
+
+// lonSpan returns the width, in degrees, of the longitude range
+// [from, to], measured going east from from to to, wrapping through
+// +/-180 if needed. The result is in [0, 360) and is not necessarily
+// the shorter of the two arcs between from and to.
+func lonSpan(from, to float64) float64 {
+	d := to - from
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+// Extend grows the bound to include the new point, wrapping the
+// longitude range through the anti-meridian if that produces the
+// smaller of the two possible spans.
+func (b GeoBound) Extend(point Point) GeoBound {
+	if b.Contains(point) {
+		return b
+	}
+
+	lat := b.Min[1]
+	if point[1] < lat {
+		lat = point[1]
+	}
+	maxLat := b.Max[1]
+	if point[1] > maxLat {
+		maxLat = point[1]
+	}
+
+	// The point's longitude is already in the arc, so only the
+	// latitude range needs to grow.
+	if lonSpan(b.Min[0], point[0]) <= lonSpan(b.Min[0], b.Max[0]) {
+		return GeoBound{Point{b.Min[0], lat}, Point{b.Max[0], maxLat}}
+	}
+
+	// The point isn't in the current longitude arc [Min[0], Max[0]]
+	// (measured eastward, wrapping through +/-180 if Wraps()), so it
+	// sits somewhere in the complementary gap. We can close that gap
+	// from either end: push Max forward to the point, or pull Min
+	// back to it. Whichever produces the smaller resulting arc wins -
+	// this falls out of lonSpan alone, with no separate wrap/no-wrap
+	// case needed, since Min[0] > Max[0] already means "wraps".
+	growForward := lonSpan(b.Min[0], point[0])
+	growBackward := lonSpan(point[0], b.Max[0])
+
+	if growForward <= growBackward {
+		return GeoBound{Point{b.Min[0], lat}, Point{point[0], maxLat}}
+	}
+	return GeoBound{Point{point[0], lat}, Point{b.Max[0], maxLat}}
+}
+
+// Union extends this bound to contain the union of this and the given bound.
+func (b GeoBound) Union(other GeoBound) GeoBound {
+	b = b.Extend(other.Min)
+	b = b.Extend(other.Max)
+
+	return b
+}
+
+// Contains determines if the point is within the bound.
+// Points on the boundary are considered within. Longitude is tested
+// with wraparound when the bound crosses the anti-meridian.
+func (b GeoBound) Contains(point Point) bool {
+	if point[1] < b.Min[1] || b.Max[1] < point[1] {
+		return false
+	}
+
+	if b.Wraps() {
+		return point[0] >= b.Min[0] || point[0] <= b.Max[0]
+	}
+
+	return point[0] >= b.Min[0] && point[0] <= b.Max[0]
+}
+
+// Intersects determines if two bounds intersect. Returns true if they
+// are touching. Handles all four combinations of wrapped and
+// non-wrapped longitude ranges.
+func (b GeoBound) Intersects(bound GeoBound) bool {
+	if b.Max[1] < bound.Min[1] || b.Min[1] > bound.Max[1] {
+		return false
+	}
+
+	switch {
+	case !b.Wraps() && !bound.Wraps():
+		return b.Max[0] >= bound.Min[0] && b.Min[0] <= bound.Max[0]
+	case b.Wraps() && !bound.Wraps():
+		return bound.Max[0] >= b.Min[0] || bound.Min[0] <= b.Max[0]
+	case !b.Wraps() && bound.Wraps():
+		return b.Max[0] >= bound.Min[0] || b.Min[0] <= bound.Max[0]
+	default:
+		// both wrap, so both necessarily include the anti-meridian
+		return true
+	}
+}
+
+// ToRings converts the bound into the rings of its boundary. A bound
+// that wraps the anti-meridian is split into two rings, one on each
+// side of +/-180, so the result can always be fed into a GeoJSON
+// Polygon or MultiPolygon without crossing the seam.
+func (b GeoBound) ToRings() []Ring {
+	if !b.Wraps() {
+		return []Ring{
+			{
+				b.Min,
+				Point{b.Max[0], b.Min[1]},
+				b.Max,
+				Point{b.Min[0], b.Max[1]},
+				b.Min,
+			},
+		}
+	}
+
+	west := Ring{
+		Point{b.Min[0], b.Min[1]},
+		Point{180, b.Min[1]},
+		Point{180, b.Max[1]},
+		Point{b.Min[0], b.Max[1]},
+		Point{b.Min[0], b.Min[1]},
+	}
+	east := Ring{
+		Point{-180, b.Min[1]},
+		Point{b.Max[0], b.Min[1]},
+		Point{b.Max[0], b.Max[1]},
+		Point{-180, b.Max[1]},
+		Point{-180, b.Min[1]},
+	}
+
+	return []Ring{west, east}
+}
+
+// ToMultiPolygon converts the bound into a MultiPolygon, with one
+// polygon per ring returned by ToRings. For a non-wrapping bound this
+// is just a single-polygon MultiPolygon.
+func (b GeoBound) ToMultiPolygon() MultiPolygon {
+	rings := b.ToRings()
+	mp := make(MultiPolygon, len(rings))
+	for i, r := range rings {
+		mp[i] = Polygon{r}
+	}
+
+	return mp
+}
+
+// Bound drops the anti-meridian awareness and returns the plain,
+// planar Bound with the same corners. Callers that know their data
+// never wraps can use this to interoperate with Bound-based APIs.
+func (b GeoBound) Bound() Bound {
+	return Bound{Min: b.Min, Max: b.Max}
+}
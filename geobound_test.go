@@ -0,0 +1,108 @@
+package orb
+
+import "testing"
+
+func TestNewGeoBoundFromPoints(t *testing.T) {
+	cases := []struct {
+		name      string
+		a, b      Point
+		wantMin   Point
+		wantMax   Point
+		wantWraps bool
+	}{
+		{
+			name:      "normal, no wrap",
+			a:         Point{-10, 0},
+			b:         Point{10, 5},
+			wantMin:   Point{-10, 0},
+			wantMax:   Point{10, 5},
+			wantWraps: false,
+		},
+		{
+			name:      "across the dateline, should wrap",
+			a:         Point{175, -18},
+			b:         Point{-175, -17},
+			wantMin:   Point{175, -18},
+			wantMax:   Point{-175, -17},
+			wantWraps: true,
+		},
+		{
+			name:      "pole-adjacent, latitude still plain min/max",
+			a:         Point{170, 89},
+			b:         Point{-170, 85},
+			wantMin:   Point{170, 85},
+			wantMax:   Point{-170, 89},
+			wantWraps: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := NewGeoBoundFromPoints(c.a, c.b)
+			if got.Min != c.wantMin || got.Max != c.wantMax {
+				t.Errorf("got {%v, %v}, want {%v, %v}", got.Min, got.Max, c.wantMin, c.wantMax)
+			}
+			if got.Wraps() != c.wantWraps {
+				t.Errorf("Wraps() = %v, want %v", got.Wraps(), c.wantWraps)
+			}
+		})
+	}
+}
+
+func TestGeoBoundContains(t *testing.T) {
+	fiji := NewGeoBoundFromPoints(Point{175, -18}, Point{-175, -17})
+
+	cases := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"inside, near the west edge", Point{176, -17.5}, true},
+		{"inside, across the seam", Point{-176, -17.5}, true},
+		{"on the anti-meridian itself", Point{180, -17.5}, true},
+		{"outside, opposite side of the world", Point{0, -17.5}, false},
+		{"outside, right latitude wrong longitude", Point{170, -17.5}, false},
+		{"outside, right longitude wrong latitude", Point{176, 0}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := fiji.Contains(c.p); got != c.want {
+				t.Errorf("Contains(%v) = %v, want %v", c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestGeoBoundIntersects(t *testing.T) {
+	west := NewGeoBoundFromPoints(Point{170, -10}, Point{178, 10})
+	east := NewGeoBoundFromPoints(Point{-178, -10}, Point{-170, 10})
+	wrapping := NewGeoBoundFromPoints(Point{175, -18}, Point{-175, 10})
+	farAway := NewGeoBoundFromPoints(Point{0, -10}, Point{10, 10})
+
+	if west.Intersects(east) {
+		t.Error("two small non-wrapping bounds on opposite sides of the dateline should not intersect")
+	}
+	if !wrapping.Intersects(west) {
+		t.Error("wrapping bound should intersect the non-wrapping bound on its west side")
+	}
+	if !wrapping.Intersects(east) {
+		t.Error("wrapping bound should intersect the non-wrapping bound on its east side")
+	}
+	if wrapping.Intersects(farAway) {
+		t.Error("wrapping bound should not intersect a bound nowhere near the dateline")
+	}
+}
+
+func TestGeoBoundUnionWraps(t *testing.T) {
+	west := NewGeoBoundFromPoints(Point{170, 0}, Point{178, 10})
+	east := NewGeoBoundFromPoints(Point{-178, 0}, Point{-170, 10})
+
+	got := west.Union(east)
+	if !got.Wraps() {
+		t.Fatalf("Union of two dateline-adjacent bounds should wrap, got %+v", got)
+	}
+	if got.Min != (Point{170, 0}) || got.Max != (Point{-170, 10}) {
+		t.Errorf("got {%v, %v}, want {{170 0}, {-170 10}}", got.Min, got.Max)
+	}
+}
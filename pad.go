@@ -0,0 +1,44 @@
+package orb
+
+// Pad expands the bound by delta, in coordinate units, on every side.
+// A negative delta insets the bound; if that inversion makes
+// Min greater than Max, the bound is left in the malformed state
+// that IsEmpty already detects.
+func (b Bound) Pad(delta float64) Bound {
+	return b.PadBy(delta, delta, delta, delta)
+}
+
+// PadBy expands the bound by a different amount on each side. Negative
+// values inset that side instead. As with Pad, padding past the
+// opposite edge produces the malformed state reported by IsEmpty.
+func (b Bound) PadBy(left, bottom, right, top float64) Bound {
+	return Bound{
+		Min: Point{b.Min[0] - left, b.Min[1] - bottom},
+		Max: Point{b.Max[0] + right, b.Max[1] + top},
+	}
+}
+
+// PadPercent expands the bound by a fraction of its current width and
+// height on every side, e.g. 0.1 adds a 10% margin around the bound.
+// A negative fraction insets it.
+func (b Bound) PadPercent(fraction float64) Bound {
+	dx := (b.Max[0] - b.Min[0]) * fraction
+	dy := (b.Max[1] - b.Min[1]) * fraction
+
+	return Bound{
+		Min: Point{b.Min[0] - dx, b.Min[1] - dy},
+		Max: Point{b.Max[0] + dx, b.Max[1] + dy},
+	}
+}
+
+// PadMeters expands the bound, interpreted as lon/lat degrees, by a
+// metric distance d on every side - the geo-side counterpart to Pad.
+// It delegates to SphericalBound.ExpandByMeters, which accounts for
+// longitude degrees shrinking at higher latitudes, so callers asking
+// for "everything within d meters of this box" don't have to
+// reinvent that scaling or reach for SphericalBound themselves.
+// A result that crosses +/-180 comes back with Min[0] > Max[0], the
+// wrapped form SphericalBound uses, which Bound reports via IsEmpty.
+func (b Bound) PadMeters(d float64) Bound {
+	return b.Spherical().ExpandByMeters(d).Bound()
+}
@@ -0,0 +1,45 @@
+package orb
+
+import "testing"
+
+func TestBoundPad(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+
+	got := b.Pad(2)
+	want := Bound{Min: Point{-2, -2}, Max: Point{12, 12}}
+	if got != want {
+		t.Errorf("Pad(2) = %+v, want %+v", got, want)
+	}
+}
+
+func TestBoundPadByNegativeInsetsProducesMalformedBound(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+
+	got := b.PadBy(-20, 0, -20, 0)
+	if !got.IsEmpty() {
+		t.Errorf("insetting past the opposite edge should be reported by IsEmpty, got %+v", got)
+	}
+}
+
+func TestBoundPadPercent(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{10, 20}}
+
+	got := b.PadPercent(0.1)
+	want := Bound{Min: Point{-1, -2}, Max: Point{11, 22}}
+	if got != want {
+		t.Errorf("PadPercent(0.1) = %+v, want %+v", got, want)
+	}
+}
+
+func TestBoundPadMeters(t *testing.T) {
+	b := Bound{Min: Point{0, 0}, Max: Point{1, 1}}
+
+	got := b.PadMeters(1000)
+	want := b.Spherical().ExpandByMeters(1000).Bound()
+	if got != want {
+		t.Errorf("PadMeters(1000) = %+v, want %+v (same as ExpandByMeters)", got, want)
+	}
+	if !got.ContainsBound(b) {
+		t.Errorf("PadMeters should only ever grow the bound, got %+v for input %+v", got, b)
+	}
+}
@@ -0,0 +1,141 @@
+package orb
+
+// An Orientation describes the winding order of a Ring.
+type Orientation int
+
+const (
+	// CCW is the counter-clockwise winding order.
+	CCW Orientation = 1
+
+	// CW is the clockwise winding order.
+	CW Orientation = -1
+)
+
+// Orientation returns whether the ring is wound clockwise or
+// counter-clockwise, via the sign of its shoelace (signed) area. A
+// degenerate ring with zero signed area is reported as CCW.
+func (r Ring) Orientation() Orientation {
+	var sum float64
+	for i := 0; i < len(r)-1; i++ {
+		sum += (r[i+1][0] - r[i][0]) * (r[i+1][1] + r[i][1])
+	}
+
+	if sum > 0 {
+		return CW
+	}
+	return CCW
+}
+
+// Reorient returns the ring wound in the given orientation. A ring
+// that already has that orientation is returned as is, sharing its
+// points; otherwise the result is a reversed copy.
+func (r Ring) Reorient(o Orientation) Ring {
+	if r.Orientation() == o {
+		return r
+	}
+
+	reversed := make(Ring, len(r))
+	for i, p := range r {
+		reversed[len(r)-1-i] = p
+	}
+
+	return reversed
+}
+
+// ContainsPoint determines if the point is within the ring, using the
+// standard ray-casting algorithm. Edges are treated half-open (the
+// top/right endpoint of each edge is excluded) so a ray passing
+// exactly through a vertex is never counted twice.
+func (r Ring) ContainsPoint(point Point) bool {
+	in := false
+
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		pi, pj := r[i], r[j]
+
+		if (pi[1] > point[1]) != (pj[1] > point[1]) {
+			x := pj[0] + (point[1]-pj[1])*(pi[0]-pj[0])/(pi[1]-pj[1])
+			if point[0] < x {
+				in = !in
+			}
+		}
+	}
+
+	return in
+}
+
+// ContainsRing determines if the other ring is entirely contained
+// within this ring: other's bound must fit inside this ring's bound,
+// at least one vertex of other must be inside this ring, and no edge
+// of other may cross any edge of this ring.
+func (r Ring) ContainsRing(other Ring) bool {
+	if !r.Bound().ContainsBound(other.Bound()) {
+		return false
+	}
+
+	if len(other) == 0 || !r.ContainsPoint(other[0]) {
+		return false
+	}
+
+	for i := 0; i < len(r)-1; i++ {
+		a, b := r[i], r[i+1]
+		for j := 0; j < len(other)-1; j++ {
+			c, d := other[j], other[j+1]
+			if segmentsCross(a, b, c, d) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Bound returns the bounding box of the ring.
+func (r Ring) Bound() Bound {
+	if len(r) == 0 {
+		return Bound{}
+	}
+
+	b := Bound{Min: r[0], Max: r[0]}
+	for _, p := range r[1:] {
+		b = b.Extend(p)
+	}
+
+	return b
+}
+
+// orientSign returns the sign of the cross product (b-a) x (c-a):
+// positive if a,b,c turn counter-clockwise, negative if clockwise,
+// zero if collinear.
+func orientSign(a, b, c Point) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// segmentsCross determines if segment a-b properly crosses segment
+// c-d, using the orientation-sign test on the triangles (a,b,c),
+// (a,b,d), (c,d,a), (c,d,b). Segments sharing an endpoint, touching
+// or collinear segments are not considered crossing.
+func segmentsCross(a, b, c, d Point) bool {
+	if a == c || a == d || b == c || b == d {
+		return false
+	}
+
+	d1 := orientSign(a, b, c)
+	d2 := orientSign(a, b, d)
+	d3 := orientSign(c, d, a)
+	d4 := orientSign(c, d, b)
+
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
+
+// ContainsBound determines if the given bound is entirely contained
+// within this bound.
+func (b Bound) ContainsBound(o Bound) bool {
+	return b.Contains(o.Min) && b.Contains(o.Max)
+}
+
+// ContainsRing determines if the ring is entirely contained within
+// this bound, i.e. the ring's own bound fits inside this one.
+func (b Bound) ContainsRing(r Ring) bool {
+	return b.ContainsBound(r.Bound())
+}
@@ -0,0 +1,76 @@
+package orb
+
+import "testing"
+
+func square(min, max float64) Ring {
+	return Ring{
+		{min, min},
+		{max, min},
+		{max, max},
+		{min, max},
+		{min, min},
+	}
+}
+
+func TestRingOrientation(t *testing.T) {
+	ccw := square(0, 10)
+	cw := ccw.Reorient(CW)
+
+	if ccw.Orientation() != CCW {
+		t.Errorf("square built min->max->... should be CCW, got %v", ccw.Orientation())
+	}
+	if cw.Orientation() != CW {
+		t.Errorf("Reorient(CW) should produce a CW ring, got %v", cw.Orientation())
+	}
+}
+
+func TestRingContainsPoint(t *testing.T) {
+	r := square(0, 10)
+
+	cases := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"inside", Point{5, 5}, true},
+		{"outside", Point{20, 20}, false},
+		{"on an edge", Point{0, 5}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := r.ContainsPoint(c.p); got != c.want {
+				t.Errorf("ContainsPoint(%v) = %v, want %v", c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestRingContainsRing(t *testing.T) {
+	outer := square(0, 10)
+	inner := square(2, 8)
+	overlapping := square(5, 15)
+	disjoint := square(20, 30)
+
+	if !outer.ContainsRing(inner) {
+		t.Error("outer should contain the fully nested inner ring")
+	}
+	if outer.ContainsRing(overlapping) {
+		t.Error("outer should not contain a ring whose edges cross its own")
+	}
+	if outer.ContainsRing(disjoint) {
+		t.Error("outer should not contain a disjoint ring")
+	}
+}
+
+func TestBoundContainsBoundAndRing(t *testing.T) {
+	outer := Bound{Min: Point{0, 0}, Max: Point{10, 10}}
+	inner := Bound{Min: Point{2, 2}, Max: Point{8, 8}}
+
+	if !outer.ContainsBound(inner) {
+		t.Error("outer bound should contain the fully nested inner bound")
+	}
+	if !outer.ContainsRing(square(2, 8)) {
+		t.Error("outer bound should contain a ring whose bound is fully nested")
+	}
+}
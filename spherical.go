@@ -0,0 +1,198 @@
+package orb
+
+import "math"
+
+// earthRadius is the mean radius of the earth in meters, matching the
+// value used throughout the geo-aware parts of this package.
+const earthRadius = 6371008.8
+
+// A SphericalBound wraps a Bound whose Min/Max are (lon, lat) degrees
+// on the WGS84 sphere, and provides geometry operations that respect
+// the curvature of the earth rather than treating the coordinates as
+// a flat (x, y) plane.
+type SphericalBound struct {
+	Min, Max Point
+}
+
+// Spherical reinterprets this planar Bound as lon/lat degrees on the
+// sphere. It is an opt-in constructor: existing planar users of Bound
+// are completely unaffected.
+func (b Bound) Spherical() SphericalBound {
+	return SphericalBound{Min: b.Min, Max: b.Max}
+}
+
+// Bound drops the spherical interpretation and returns the
+// underlying planar Bound with the same corners.
+func (b SphericalBound) Bound() Bound {
+	return Bound{Min: b.Min, Max: b.Max}
+}
+
+// ContainsPoint determines if the point, given as (lon, lat) degrees,
+// is within the bound. Latitude is a plain range check; longitude
+// wraps through the anti-meridian when Min[0] > Max[0].
+func (b SphericalBound) ContainsPoint(point Point) bool {
+	lat := clampLat(point[1])
+	if lat < b.Min[1] || b.Max[1] < lat {
+		return false
+	}
+
+	lon := point[0]
+	if b.Min[0] > b.Max[0] {
+		return lon >= b.Min[0] || lon <= b.Max[0]
+	}
+
+	return lon >= b.Min[0] && lon <= b.Max[0]
+}
+
+// IntersectsBound determines if two spherical bounds overlap, treating
+// latitude as the closed range [Min[1], Max[1]] and longitude as an
+// arc interval on the circle S1, so anti-meridian-wrapping bounds are
+// handled correctly.
+func (b SphericalBound) IntersectsBound(o SphericalBound) bool {
+	if b.Max[1] < o.Min[1] || b.Min[1] > o.Max[1] {
+		return false
+	}
+
+	bWraps := b.Min[0] > b.Max[0]
+	oWraps := o.Min[0] > o.Max[0]
+
+	switch {
+	case !bWraps && !oWraps:
+		return b.Max[0] >= o.Min[0] && b.Min[0] <= o.Max[0]
+	case bWraps && !oWraps:
+		return o.Max[0] >= b.Min[0] || o.Min[0] <= b.Max[0]
+	case !bWraps && oWraps:
+		return b.Max[0] >= o.Min[0] || b.Min[0] <= o.Max[0]
+	default:
+		return true
+	}
+}
+
+// Area returns the surface area of the bound on the WGS84 sphere, in
+// square meters, using R^2 * (sin(latMax) - sin(latMin)) * deltaLon.
+func (b SphericalBound) Area() float64 {
+	lonDelta := b.Max[0] - b.Min[0]
+	if lonDelta < 0 {
+		lonDelta += 360
+	}
+
+	return earthRadius * earthRadius *
+		(math.Sin(deg2rad(b.Max[1])) - math.Sin(deg2rad(b.Min[1]))) *
+		deg2rad(lonDelta)
+}
+
+// ExpandByMeters grows the bound by a metric distance d on every side.
+// Latitude converts directly via the earth's radius; longitude is
+// scaled by the latitude of whichever edge (north or south) is
+// closer to the pole, since a degree of longitude shrinks as
+// latitude increases.
+func (b SphericalBound) ExpandByMeters(d float64) SphericalBound {
+	latDelta := rad2deg(d / earthRadius)
+
+	newMinLat := clampLat(b.Min[1] - latDelta)
+	newMaxLat := clampLat(b.Max[1] + latDelta)
+
+	farLat := math.Max(math.Abs(newMinLat), math.Abs(newMaxLat))
+	lonScale := math.Cos(deg2rad(farLat))
+	if lonScale < 1e-9 {
+		// pole-adjacent: a tiny band of latitude covers every longitude.
+		return SphericalBound{
+			Min: Point{-180, newMinLat},
+			Max: Point{180, newMaxLat},
+		}
+	}
+	lonDelta := rad2deg(d / (earthRadius * lonScale))
+	if lonSpan(b.Min[0], b.Max[0])+2*lonDelta >= 360 {
+		// the expanded longitude arc reaches all the way around.
+		return SphericalBound{
+			Min: Point{-180, newMinLat},
+			Max: Point{180, newMaxLat},
+		}
+	}
+
+	return SphericalBound{
+		Min: Point{normalizeLon(b.Min[0] - lonDelta), newMinLat},
+		Max: Point{normalizeLon(b.Max[0] + lonDelta), newMaxLat},
+	}
+}
+
+// A Cap is a spherical cap: all points within Radius meters of Center.
+// It's the natural bounding shape for radius queries ("find everything
+// within 5km of here"), in the same way Bound is for box queries.
+type Cap struct {
+	Center Point
+	Radius float64
+}
+
+// Cap returns a bounding cap, i.e. a center point and a radius in
+// meters, that contains the whole bound. The center is the midpoint
+// of the bound's longitude arc and latitude range, so the result is
+// not in general the smallest cap that contains the bound.
+func (b SphericalBound) Cap() Cap {
+	// The center longitude is the midpoint of the eastward arc from
+	// Min[0] to Max[0], not the plain numeric average - for a bound
+	// that wraps the anti-meridian (Min[0] > Max[0]) the plain average
+	// lands on the opposite side of the globe from the box itself.
+	center := Point{
+		normalizeLon(b.Min[0] + lonSpan(b.Min[0], b.Max[0])/2),
+		(b.Min[1] + b.Max[1]) / 2,
+	}
+
+	r := 0.0
+	corners := []Point{
+		b.Min, b.Max,
+		{b.Min[0], b.Max[1]},
+		{b.Max[0], b.Min[1]},
+	}
+	// On a meridian edge more than 90 degrees of longitude from the
+	// center, the farthest point can lie between the two corners.
+	cLat := deg2rad(center[1])
+	for _, lon := range []float64{b.Min[0], b.Max[0]} {
+		dLon := deg2rad(lon - center[0])
+		lat := rad2deg(math.Atan2(-math.Sin(cLat), -math.Cos(cLat)*math.Cos(dLon)))
+		if lat >= b.Min[1] && lat <= b.Max[1] {
+			corners = append(corners, Point{lon, lat})
+		}
+	}
+	for _, c := range corners {
+		if d := haversineMeters(center, c); d > r {
+			r = d
+		}
+	}
+
+	return Cap{Center: center, Radius: r}
+}
+
+func deg2rad(d float64) float64 { return d * math.Pi / 180 }
+func rad2deg(r float64) float64 { return r * 180 / math.Pi }
+
+func clampLat(lat float64) float64 {
+	if lat < -90 {
+		return -90
+	}
+	if lat > 90 {
+		return 90
+	}
+	return lat
+}
+
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
+
+// haversineMeters returns the great-circle distance between two
+// (lon, lat) points, in meters, on the WGS84 sphere.
+func haversineMeters(a, b Point) float64 {
+	lat1, lat2 := deg2rad(a[1]), deg2rad(b[1])
+	dLat := lat2 - lat1
+	dLon := deg2rad(b[0] - a[0])
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
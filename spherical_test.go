@@ -0,0 +1,81 @@
+package orb
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSphericalBoundContainsPoint(t *testing.T) {
+	b := Bound{Min: Point{-10, -10}, Max: Point{10, 10}}.Spherical()
+
+	cases := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"center", Point{0, 0}, true},
+		{"on the boundary", Point{10, 10}, true},
+		{"outside", Point{20, 0}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := b.ContainsPoint(c.p); got != c.want {
+				t.Errorf("ContainsPoint(%v) = %v, want %v", c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestSphericalBoundAreaEquator(t *testing.T) {
+	// A narrow band straddling the equator, one degree of latitude by
+	// one degree of longitude, should be close to 111km x 111km.
+	b := Bound{Min: Point{0, -0.5}, Max: Point{1, 0.5}}.Spherical()
+
+	got := b.Area()
+	want := 111195.0 * 111195.0
+	if math.Abs(got-want)/want > 0.05 {
+		t.Errorf("Area() = %v, want close to %v", got, want)
+	}
+}
+
+func TestSphericalBoundExpandByMetersNearPole(t *testing.T) {
+	b := Bound{Min: Point{0, 89.9}, Max: Point{1, 89.95}}.Spherical()
+
+	got := b.ExpandByMeters(200000)
+	if got.Min[0] != -180 || got.Max[0] != 180 {
+		t.Errorf("expanding a pole-adjacent bound should cover all longitudes, got Min[0]=%v Max[0]=%v", got.Min[0], got.Max[0])
+	}
+}
+
+func TestSphericalBoundCap(t *testing.T) {
+	b := Bound{Min: Point{-1, -1}, Max: Point{1, 1}}.Spherical()
+
+	cap := b.Cap()
+	for _, corner := range []Point{b.Min, b.Max, {b.Min[0], b.Max[1]}, {b.Max[0], b.Min[1]}} {
+		if d := haversineMeters(cap.Center, corner); d > cap.Radius+1 {
+			t.Errorf("corner %v is %vm from cap center, outside radius %v", corner, d, cap.Radius)
+		}
+	}
+}
+
+func TestSphericalBoundCapWraps(t *testing.T) {
+	// A small box straddling the anti-meridian: its center should sit
+	// near lon 180/-180, not lon 0 on the opposite side of the globe.
+	b := Bound{Min: Point{175, -1}, Max: Point{-175, 1}}.Spherical()
+
+	cap := b.Cap()
+	if cap.Center[0] < 178 && cap.Center[0] > -178 {
+		t.Errorf("Cap() center longitude %v should be near +/-180, not the far side of the globe", cap.Center[0])
+	}
+
+	for _, corner := range []Point{b.Min, b.Max, {b.Min[0], b.Max[1]}, {b.Max[0], b.Min[1]}} {
+		if d := haversineMeters(cap.Center, corner); d > cap.Radius+1 {
+			t.Errorf("corner %v is %vm from cap center, outside radius %v", corner, d, cap.Radius)
+		}
+	}
+
+	if cap.Radius > 1000000 {
+		t.Errorf("Cap() radius for a ~10-degree-wide box should be on the order of a few hundred km, not %vm", cap.Radius)
+	}
+}